@@ -1,36 +1,115 @@
 package main
 
 import (
+	"context"
+	"encoding/gob"
 	"fmt"
+	"io/fs"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/a1exfox/go-course/pkg/config"
 	"github.com/a1exfox/go-course/pkg/handlers"
+	"github.com/a1exfox/go-course/pkg/helpers"
+	"github.com/a1exfox/go-course/pkg/models"
 	"github.com/a1exfox/go-course/pkg/render"
+	"github.com/a1exfox/go-course/pkg/routes"
+	"github.com/alexedwards/scs/v2"
 )
 
-const portNumber = ":8080"
+var app config.AppConfig
+var session *scs.SessionManager
 
 func main() {
+	srv, err := run()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "cannot start application:", err)
+		os.Exit(1)
+	}
+
+	app.InfoLog.Println("Starting application on", srv.Addr)
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			app.ErrorLog.Fatal(err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	app.InfoLog.Println("Shutting down application")
+	if err := srv.Shutdown(ctx); err != nil {
+		app.ErrorLog.Fatal(err)
+	}
+}
+
+// run performs all application wiring and returns a ready to serve
+// *http.Server, so that it can be exercised from tests without binding
+// to a port.
+func run() (*http.Server, error) {
+	gob.Register(models.Reservation{})
+
+	app.InfoLog = log.New(os.Stdout, "INFO\t", log.Ldate|log.Ltime)
+	app.ErrorLog = log.New(os.Stdout, "ERROR\t", log.Ldate|log.Ltime|log.Lshortfile)
 
-	var app config.AppConfig
-	tc, err := render.CreateTemplateCache()
+	settings, err := config.Load()
 	if err != nil {
-		log.Fatal("cannot create template cache ", err)
+		return nil, err
+	}
+
+	app.InProduction = settings.InProduction
+
+	session = scs.New()
+	session.Lifetime = settings.SessionLifetime
+	session.Cookie.Persist = true
+	session.Cookie.SameSite = http.SameSiteLaxMode
+	session.Cookie.Secure = app.InProduction
+
+	app.Session = session
+
+	app.UseCache = settings.Embed
+	app.TemplateDir = settings.TemplateDir
+
+	var templatesFS fs.FS
+	if app.UseCache {
+		templatesFS, err = render.EmbeddedTemplatesFS()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		templatesFS = os.DirFS(settings.TemplateDir)
+	}
+
+	tc, err := render.CreateTemplateCache(templatesFS)
+	if err != nil {
+		return nil, err
 	}
 
 	app.TemplateCache = tc
-	app.UseCache = true
 
 	repo := handlers.NewRepo(&app)
 	handlers.NewHandlers(repo)
 
 	render.NewTemplates(&app)
+	helpers.NewHelpers(&app)
 
-	http.HandleFunc("/", handlers.Repo.Home)
-	http.HandleFunc("/about", handlers.Repo.About)
+	srv := &http.Server{
+		Addr:         settings.BindAddress,
+		Handler:      routes.New(&app),
+		ErrorLog:     app.ErrorLog,
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		IdleTimeout:  time.Minute,
+	}
 
-	fmt.Println("Starting application on port", portNumber)
-	http.ListenAndServe(portNumber, nil)
+	return srv, nil
 }
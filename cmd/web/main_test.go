@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+func TestRun(t *testing.T) {
+	srv, err := run()
+	if err != nil {
+		t.Fatal("run returned an error:", err)
+	}
+
+	const wantAddr = ":8080"
+	if srv.Addr != wantAddr {
+		t.Errorf("expected server address %q, got %q", wantAddr, srv.Addr)
+	}
+
+	if app.Session == nil {
+		t.Error("expected session manager to be set up by run")
+	}
+}
@@ -0,0 +1,20 @@
+package config
+
+import (
+	"html/template"
+	"log"
+
+	"github.com/alexedwards/scs/v2"
+)
+
+// AppConfig holds the application wide configuration that is shared
+// across handlers, middleware and the render package.
+type AppConfig struct {
+	UseCache      bool
+	TemplateCache map[string]*template.Template
+	TemplateDir   string
+	InProduction  bool
+	Session       *scs.SessionManager
+	InfoLog       *log.Logger
+	ErrorLog      *log.Logger
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadFromPrecedence(t *testing.T) {
+	confPath := filepath.Join(t.TempDir(), "app.toml")
+	confBody := "BindAddress = \":9001\"\nEmbed = false\n"
+	if err := os.WriteFile(confPath, []byte(confBody), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	env := map[string]string{}
+	getenv := func(key string) string { return env[key] }
+
+	s, err := LoadFrom(nil, getenv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.BindAddress != ":8080" || !s.Embed {
+		t.Fatalf("defaults: got %+v", s)
+	}
+
+	s, err = LoadFrom([]string{"-config=" + confPath}, getenv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.BindAddress != ":9001" || s.Embed {
+		t.Fatalf("file should override defaults: got %+v", s)
+	}
+
+	env["APP_BIND_ADDRESS"] = ":9002"
+	s, err = LoadFrom([]string{"-config=" + confPath}, getenv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.BindAddress != ":9002" {
+		t.Fatalf("env should override file: got %+v", s)
+	}
+
+	s, err = LoadFrom([]string{"-config=" + confPath, "-bind=:9003", "-embed=true"}, getenv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.BindAddress != ":9003" || !s.Embed {
+		t.Fatalf("flags should override env and file: got %+v", s)
+	}
+}
+
+func TestLoadFromValidatesRequiredFields(t *testing.T) {
+	getenv := func(string) string { return "" }
+
+	if _, err := LoadFrom([]string{"-bind="}, getenv); err == nil {
+		t.Fatal("expected an error for an empty bind address")
+	}
+}
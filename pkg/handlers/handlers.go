@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/a1exfox/go-course/pkg/config"
+	"github.com/a1exfox/go-course/pkg/models"
+	"github.com/a1exfox/go-course/pkg/render"
+	"github.com/go-chi/chi/v5"
+)
+
+// Repo is the repository used by the handlers.
+var Repo *Repository
+
+// Repository is the repository type for the handlers.
+type Repository struct {
+	App *config.AppConfig
+}
+
+// NewRepo creates a new handlers repository.
+func NewRepo(a *config.AppConfig) *Repository {
+	return &Repository{
+		App: a,
+	}
+}
+
+// NewHandlers sets the repository used by the handlers.
+func NewHandlers(r *Repository) {
+	Repo = r
+}
+
+// Home is the handler for the home page.
+func (m *Repository) Home(w http.ResponseWriter, r *http.Request) {
+	m.App.Session.Put(r.Context(), "remote_ip", r.RemoteAddr)
+
+	render.RenderTemplate(w, r, "home.page.tmpl", &models.TemplateData{})
+}
+
+// About is the handler for the about page.
+func (m *Repository) About(w http.ResponseWriter, r *http.Request) {
+	stringMap := make(map[string]string)
+	stringMap["test"] = "Hello again"
+
+	remoteIP := m.App.Session.GetString(r.Context(), "remote_ip")
+	stringMap["remote_ip"] = remoteIP
+
+	render.RenderTemplate(w, r, "about.page.tmpl", &models.TemplateData{
+		StringMap: stringMap,
+	})
+}
+
+// Room is the handler for a single room page, identified by its id.
+func (m *Repository) Room(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	stringMap := make(map[string]string)
+	stringMap["id"] = id
+
+	render.RenderTemplate(w, r, "room.page.tmpl", &models.TemplateData{
+		StringMap: stringMap,
+	})
+}
+
+// AdminDashboard is the handler for the admin dashboard page.
+func (m *Repository) AdminDashboard(w http.ResponseWriter, r *http.Request) {
+	render.RenderTemplate(w, r, "admin-dashboard.page.tmpl", &models.TemplateData{})
+}
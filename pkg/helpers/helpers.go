@@ -0,0 +1,29 @@
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/a1exfox/go-course/pkg/config"
+)
+
+var app *config.AppConfig
+
+// NewHelpers sets the config for the helpers package.
+func NewHelpers(a *config.AppConfig) {
+	app = a
+}
+
+// ClientError logs and reports an error caused by the client.
+func ClientError(w http.ResponseWriter, status int) {
+	app.InfoLog.Println("client error with status of", status)
+	http.Error(w, http.StatusText(status), status)
+}
+
+// ServerError logs the error and trace, then sends a generic 500 to the client.
+func ServerError(w http.ResponseWriter, err error) {
+	trace := fmt.Sprintf("%s\n%s", err.Error(), debug.Stack())
+	app.ErrorLog.Println(trace)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
@@ -0,0 +1,10 @@
+package models
+
+// Reservation holds the data kept in the session between the steps of
+// the booking flow.
+type Reservation struct {
+	FirstName string
+	LastName  string
+	Email     string
+	Phone     string
+}
@@ -0,0 +1,9 @@
+package render
+
+import "embed"
+
+// TemplateFS embeds the html templates into the compiled binary so that
+// it can be deployed as a single, self-contained artifact.
+//
+//go:embed templates/*
+var TemplateFS embed.FS
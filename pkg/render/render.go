@@ -0,0 +1,112 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"os"
+
+	"github.com/a1exfox/go-course/pkg/config"
+	"github.com/a1exfox/go-course/pkg/helpers"
+	"github.com/a1exfox/go-course/pkg/models"
+	"github.com/justinas/nosurf"
+)
+
+var app *config.AppConfig
+
+// NewTemplates sets the config for the render package.
+func NewTemplates(a *config.AppConfig) {
+	app = a
+}
+
+// EmbeddedTemplatesFS returns the html templates embedded in the binary,
+// rooted so that its entries match the layout of the on disk templates
+// directory.
+func EmbeddedTemplatesFS() (fs.FS, error) {
+	return fs.Sub(TemplateFS, "templates")
+}
+
+// AddDefaultData adds data that every template needs, regardless of
+// which handler rendered it.
+func AddDefaultData(td *models.TemplateData, r *http.Request) *models.TemplateData {
+	td.Flash = app.Session.PopString(r.Context(), "flash")
+	td.Warning = app.Session.PopString(r.Context(), "warning")
+	td.Error = app.Session.PopString(r.Context(), "error")
+	td.CSRFToken = nosurf.Token(r)
+	return td
+}
+
+// RenderTemplate renders a template using the template cache on app. When
+// app.UseCache is false the cache is rebuilt from app.TemplateDir on every
+// call, so that templates can be edited without restarting the application.
+func RenderTemplate(w http.ResponseWriter, r *http.Request, tmpl string, td *models.TemplateData) {
+	var tc map[string]*template.Template
+
+	if app.UseCache {
+		tc = app.TemplateCache
+	} else {
+		var err error
+		tc, err = CreateTemplateCache(os.DirFS(app.TemplateDir))
+		if err != nil {
+			helpers.ServerError(w, fmt.Errorf("could not rebuild template cache from %s: %w", app.TemplateDir, err))
+			return
+		}
+	}
+
+	t, ok := tc[tmpl]
+	if !ok {
+		helpers.ServerError(w, fmt.Errorf("could not get template %s from template cache", tmpl))
+		return
+	}
+
+	buf := new(bytes.Buffer)
+
+	td = AddDefaultData(td, r)
+
+	err := t.Execute(buf, td)
+	if err != nil {
+		helpers.ServerError(w, err)
+		return
+	}
+
+	_, err = buf.WriteTo(w)
+	if err != nil {
+		app.ErrorLog.Println(err)
+	}
+}
+
+// CreateTemplateCache creates a template cache by reading the page and
+// layout templates out of fsys.
+func CreateTemplateCache(fsys fs.FS) (map[string]*template.Template, error) {
+	myCache := map[string]*template.Template{}
+
+	pages, err := fs.Glob(fsys, "*.page.tmpl")
+	if err != nil {
+		return myCache, err
+	}
+
+	for _, page := range pages {
+		ts, err := template.New(page).ParseFS(fsys, page)
+		if err != nil {
+			return myCache, err
+		}
+
+		matches, err := fs.Glob(fsys, "*.layout.tmpl")
+		if err != nil {
+			return myCache, err
+		}
+
+		if len(matches) > 0 {
+			ts, err = ts.ParseFS(fsys, "*.layout.tmpl")
+			if err != nil {
+				return myCache, err
+			}
+		}
+
+		myCache[page] = ts
+	}
+
+	return myCache, nil
+}
@@ -0,0 +1,51 @@
+package render
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestCreateTemplateCacheFromEmbeddedFS(t *testing.T) {
+	fsys, err := EmbeddedTemplatesFS()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tc, err := CreateTemplateCache(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := tc["home.page.tmpl"]; !ok {
+		t.Error("expected home.page.tmpl in the cache built from the embedded filesystem")
+	}
+}
+
+func TestCreateTemplateCacheFromMapFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"base.layout.tmpl": &fstest.MapFile{Data: []byte(`{{define "base"}}{{block "content" .}}{{end}}{{end}}`)},
+		"home.page.tmpl":   &fstest.MapFile{Data: []byte(`{{template "base" .}}{{define "content"}}hi{{end}}`)},
+	}
+
+	tc, err := CreateTemplateCache(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := tc["home.page.tmpl"]; !ok {
+		t.Error("expected home.page.tmpl in the cache built from the in-memory filesystem")
+	}
+}
+
+func TestCreateTemplateCacheMissingPage(t *testing.T) {
+	fsys := fstest.MapFS{}
+
+	tc, err := CreateTemplateCache(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tc) != 0 {
+		t.Errorf("expected an empty cache, got %d entries", len(tc))
+	}
+}
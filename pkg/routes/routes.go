@@ -0,0 +1,84 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/a1exfox/go-course/pkg/config"
+	"github.com/a1exfox/go-course/pkg/handlers"
+	"github.com/a1exfox/go-course/pkg/models"
+	"github.com/a1exfox/go-course/pkg/render"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/justinas/nosurf"
+)
+
+// New builds the application's http.Handler, wiring middleware, route
+// groups and the static assets file server.
+func New(a *config.AppConfig) http.Handler {
+	mux := chi.NewRouter()
+
+	mux.Use(middleware.Recoverer)
+	mux.Use(middleware.Logger)
+	mux.Use(sessionLoad(a))
+
+	mux.NotFound(notFoundHandler(a))
+	mux.MethodNotAllowed(methodNotAllowedHandler(a))
+
+	// noSurf is applied per route, rather than with a top level mux.Use,
+	// so that a request for an unregistered method (e.g. a bare POST to
+	// a GET-only path) reaches chi's own method-not-allowed handling
+	// instead of being rejected as a CSRF failure first.
+	mux.With(noSurf(a)).Get("/", handlers.Repo.Home)
+	mux.With(noSurf(a)).Get("/about", handlers.Repo.About)
+
+	mux.Route("/admin", func(admin chi.Router) {
+		admin.With(noSurf(a)).Get("/dashboard", handlers.Repo.AdminDashboard)
+	})
+
+	mux.With(noSurf(a)).Get("/rooms/{id}", handlers.Repo.Room)
+
+	fileServer := http.FileServer(http.Dir("./static/"))
+	mux.Handle("/static/*", http.StripPrefix("/static", fileServer))
+
+	return mux
+}
+
+// noSurf adds CSRF protection to the handler it wraps: requests with an
+// unsafe method (POST and the like) that reach it must carry a valid token.
+func noSurf(a *config.AppConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		csrfHandler := nosurf.New(next)
+
+		csrfHandler.SetBaseCookie(http.Cookie{
+			HttpOnly: true,
+			Path:     "/",
+			Secure:   a.InProduction,
+			SameSite: http.SameSiteLaxMode,
+		})
+
+		return csrfHandler
+	}
+}
+
+// sessionLoad loads and saves the session on every request.
+func sessionLoad(a *config.AppConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return a.Session.LoadAndSave(next)
+	}
+}
+
+// notFoundHandler renders the 404 page through the template cache.
+func notFoundHandler(a *config.AppConfig) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		render.RenderTemplate(w, r, "404.page.tmpl", &models.TemplateData{})
+	}
+}
+
+// methodNotAllowedHandler renders the 405 page through the template cache.
+func methodNotAllowedHandler(a *config.AppConfig) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		render.RenderTemplate(w, r, "405.page.tmpl", &models.TemplateData{})
+	}
+}
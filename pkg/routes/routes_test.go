@@ -0,0 +1,75 @@
+package routes
+
+import (
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/a1exfox/go-course/pkg/config"
+	"github.com/a1exfox/go-course/pkg/helpers"
+	"github.com/a1exfox/go-course/pkg/render"
+	"github.com/alexedwards/scs/v2"
+)
+
+func testApp(t *testing.T) *config.AppConfig {
+	t.Helper()
+
+	fsys, err := render.EmbeddedTemplatesFS()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tc, err := render.CreateTemplateCache(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	session := scs.New()
+	session.Lifetime = time.Hour
+
+	a := &config.AppConfig{
+		UseCache:      true,
+		TemplateCache: tc,
+		Session:       session,
+		InfoLog:       log.New(os.Stdout, "INFO\t", log.Ldate|log.Ltime),
+		ErrorLog:      log.New(os.Stdout, "ERROR\t", log.Ldate|log.Ltime),
+	}
+
+	render.NewTemplates(a)
+	helpers.NewHelpers(a)
+
+	return a
+}
+
+func TestNewNotFound(t *testing.T) {
+	srv := httptest.NewServer(New(testApp(t)))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/no-such-page")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, resp.StatusCode)
+	}
+}
+
+func TestNewMethodNotAllowed(t *testing.T) {
+	srv := httptest.NewServer(New(testApp(t)))
+	defer srv.Close()
+
+	resp, err := http.Post(srv.URL+"/", "text/plain", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, resp.StatusCode)
+	}
+}